@@ -221,7 +221,7 @@ async def _wizard_step_context(step: str, config_store: ConfigStore) -> dict[str
                     ctx["email_smtp_port"] = p.get("smtp_port", "465")
                     ctx["email_login"] = p.get("login", "")
                     ctx["email_password"] = p.get("password", "")
-            except json.JSONDecodeError, TypeError:
+            except (json.JSONDecodeError, TypeError):
                 pass
     elif step == "telegram":
         for key, var in (
@@ -258,7 +258,7 @@ async def _wizard_step_context(step: str, config_store: ConfigStore) -> dict[str
                     ctx["cal_url"] = p.get("url", "")
                     ctx["cal_username"] = p.get("username", "")
                     ctx["cal_password"] = p.get("password", "")
-            except json.JSONDecodeError, IndexError:
+            except (json.JSONDecodeError, IndexError):
                 pass
     elif step == "search":
         val = await config_store.get("search.api_key")
@@ -1235,7 +1235,7 @@ def create_admin_app(
         raw = await config_store.get("email.providers") or "[]"
         try:
             providers = json.loads(raw)
-        except json.JSONDecodeError, TypeError:
+        except (json.JSONDecodeError, TypeError):
             providers = []
         return _render_partial("partials/email.html", providers=providers)
 
@@ -2762,6 +2762,10 @@ def create_admin_app(
         status = await wacli.auth_status()
         available = status.get("available") is True
         result: dict = {"ok": available, "response": status}
+        if available:
+            # Store health (schema version, sizes, FTS) — empty when the store
+            # is unreadable, e.g. written by a newer wacli (v0.13 refuses it).
+            result["store"] = await wacli.store_info()
         if not available:
             result["error"] = (
                 "wacli binary not found. "
@@ -3089,7 +3093,7 @@ def create_admin_app(
             if context_tokens:
                 try:
                     fallback = int(await config_store.get("compaction.context_window") or 200000)
-                except TypeError, ValueError:
+                except (TypeError, ValueError):
                     fallback = 200000
                 context_window = effective_window(
                     CompactionConfig(context_window=fallback), payload.get("model") or ""
@@ -3202,7 +3206,7 @@ def create_admin_app(
         # Coerce to int (form-encoded values arrive as strings)
         try:
             memory_id = int(cast(str, memory_id_raw))
-        except TypeError, ValueError:
+        except (TypeError, ValueError):
             raise HTTPException(400, "memory_id must be an integer")
 
         import aiosqlite
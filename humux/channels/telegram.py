@@ -233,7 +233,7 @@ class TelegramChannel:
             self._bot_id = bot.id
             uname = bot.username
             self._bot_username = uname.lower() if uname else None
-        except RuntimeError, AttributeError:
+        except (RuntimeError, AttributeError):
             # Bot info not populated yet — leave unset; addressing checks return
             # False this once and the next update retries once it is available.
             self._bot_id = None
@@ -360,7 +360,7 @@ class TelegramChannel:
             return
         try:
             self._last_chat_for_user[int(convo_user)] = folded
-        except TypeError, ValueError:
+        except (TypeError, ValueError):
             pass
 
     async def _on_text(self, update: Update, context) -> None:
@@ -750,7 +750,7 @@ class TelegramChannel:
             for t in (typing_task, placeholder_task):
                 try:
                     await t
-                except asyncio.CancelledError, Exception:
+                except (asyncio.CancelledError, Exception):
                     pass
 
     @asynccontextmanager
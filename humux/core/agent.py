@@ -160,7 +160,7 @@ def _failure_signature(name: str, params: object) -> str:
     """Stable key identifying a tool call, for the repeat-failure breaker (#78)."""
     try:
         return f"{name}:{json.dumps(params, sort_keys=True, default=str)}"
-    except TypeError, ValueError:
+    except (TypeError, ValueError):
         return f"{name}:{params!r}"
 
 
@@ -173,7 +173,7 @@ def _as_int(value: object, default: int) -> int:
     """Coerce an LLM-supplied value to int, falling back to ``default``."""
     try:
         return int(value)  # type: ignore[arg-type]
-    except TypeError, ValueError:
+    except (TypeError, ValueError):
         return default
 
 
@@ -2375,7 +2375,7 @@ class AgentCore:
             limit = params.get("limit")
             try:
                 limit = int(limit) if limit else 10
-            except TypeError, ValueError:
+            except (TypeError, ValueError):
                 limit = 10
             matches = await self.skills.search_index(query, allow=allowed, limit=max(1, limit))
             return {"skills": matches}
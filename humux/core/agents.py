@@ -230,7 +230,7 @@ def _as_tool_config(value: object) -> dict:
     if isinstance(value, str) and value.strip():
         try:
             loaded = json.loads(value)
-        except json.JSONDecodeError, ValueError:
+        except (json.JSONDecodeError, ValueError):
             return {}
         return _as_tool_config(loaded)
     return {}
@@ -248,7 +248,7 @@ def _as_chat_settings(value: object) -> dict:
     if isinstance(value, str) and value.strip():
         try:
             value = json.loads(value)
-        except json.JSONDecodeError, ValueError:
+        except (json.JSONDecodeError, ValueError):
             return {}
     if not isinstance(value, dict):
         return {}
@@ -265,7 +265,7 @@ def _as_chat_settings(value: object) -> dict:
         for u in spec.get("users") or []:
             try:
                 users.append(int(u))
-            except TypeError, ValueError:
+            except (TypeError, ValueError):
                 continue
         out[str(cid)] = {"mode": mode, "users": users}
     return out
@@ -282,7 +282,7 @@ def _as_group_chat(value: object) -> dict:
     if isinstance(value, str) and value.strip():
         try:
             value = json.loads(value)
-        except json.JSONDecodeError, ValueError:
+        except (json.JSONDecodeError, ValueError):
             return {}
     if not isinstance(value, dict) or not value.get("enabled"):
         return {}
@@ -310,7 +310,7 @@ def _as_account_list(value: object, *, sender: bool = False) -> list[dict]:
     if isinstance(value, str) and value.strip():
         try:
             value = json.loads(value)
-        except json.JSONDecodeError, ValueError:
+        except (json.JSONDecodeError, ValueError):
             return []
     if not isinstance(value, (list, tuple)):
         return []
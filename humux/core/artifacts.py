@@ -80,7 +80,7 @@ def resolve(base: Path, slug: str, file_path: str = "") -> Path | None:
     target = root / slug / rel
     try:
         resolved = target.resolve()
-    except OSError, ValueError:
+    except (OSError, ValueError):
         return None
     if target.is_symlink() or not resolved.is_relative_to(root):
         return None
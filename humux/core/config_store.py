@@ -144,7 +144,7 @@ def _parse_value(value: str) -> object:
     if value.startswith("[") or value.startswith("{"):
         try:
             return json.loads(value)
-        except json.JSONDecodeError, ValueError:
+        except (json.JSONDecodeError, ValueError):
             pass
     return value
 
@@ -181,7 +181,7 @@ def _verify_password(password: str, hashed: str, salt: str) -> bool:
     try:
         salt_bytes = base64.b64decode(salt.encode())
         expected = base64.b64decode(hashed.encode())
-    except ValueError, TypeError:
+    except (ValueError, TypeError):
         return False
     derived = hashlib.pbkdf2_hmac("sha256", password.encode(), salt_bytes, 200_000)
     return hmac.compare_digest(derived, expected)
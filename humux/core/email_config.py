@@ -126,7 +126,7 @@ async def materialize_himalaya_config(config_store) -> bool:
     if raw:
         try:
             providers = json.loads(raw)
-        except json.JSONDecodeError, TypeError:
+        except (json.JSONDecodeError, TypeError):
             log.warning("Invalid JSON in %s, ignoring", EMAIL_PROVIDERS_KEY)
 
     # Filter out providers missing required fields
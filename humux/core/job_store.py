@@ -375,7 +375,7 @@ class JobStore:
             return 0
         try:
             jobs = json.loads(raw)
-        except json.JSONDecodeError, TypeError:
+        except (json.JSONDecodeError, TypeError):
             return 0
         if not isinstance(jobs, list):
             return 0
@@ -80,7 +80,7 @@ async def _start_agent(config_store: ConfigStore):
         def _account_names(raw: str | None) -> list[str]:
             try:
                 items = json.loads(raw) if raw else []
-            except ValueError, TypeError:
+            except (ValueError, TypeError):
                 return []
             return [
                 str(i.get("name", "")).strip()
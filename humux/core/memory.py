@@ -428,7 +428,7 @@ def _parse_sqlite_ts(ts: str | None) -> datetime | None:
         return None
     try:
         return datetime.strptime(ts, "%Y-%m-%d %H:%M:%S").replace(tzinfo=UTC)
-    except ValueError, TypeError:
+    except (ValueError, TypeError):
         return None
 
 
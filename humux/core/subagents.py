@@ -76,7 +76,7 @@ def resolve_cap(value: object, ceiling: int, floor: int = 1) -> int:
         return ceiling
     try:
         return max(floor, min(int(value), ceiling))  # type: ignore[arg-type]
-    except TypeError, ValueError, OverflowError:
+    except (TypeError, ValueError, OverflowError):
         # OverflowError: json.loads accepts the literal `Infinity`, and int(inf)
         # overflows — degrade it to the ceiling like any other bad value.
         return ceiling
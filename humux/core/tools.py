@@ -96,6 +96,9 @@ Read (sync first whenever checking for new/recent messages):
   wacli --json messages list --limit 20
   wacli --json messages search "invoice" --chat <jid>
   wacli --json contacts search "Marco"
+  wacli --json find "marco invoice"   (unified search: messages+contacts+chats+groups)
+  wacli --json digest --since 24h     (per-chat summary for "what did I miss")
+  wacli --json messages snippets "<question>" --k 8   (compact quotes to reason over)
 JIDs: users are `<phone>@s.whatsapp.net` (digits only, no `+`); groups `<id>@g.us`.
 Run `load_skill wacli-whatsapp` for the full command reference.
 </tool>"""
@@ -53,7 +53,7 @@ def _coerce_fernet_key(value: str | bytes) -> bytes:
     try:
         Fernet(raw)  # validates length + base64 alphabet
         return raw
-    except ValueError, TypeError:
+    except (ValueError, TypeError):
         digest = hashlib.sha256(raw).digest()
         return base64.urlsafe_b64encode(digest)
 
@@ -160,7 +160,7 @@ class AgentVault:
             salt = base64.b64decode(salt_b64)
             wrapped = base64.b64decode(wrapped_dek_b64)
             dek = Fernet(derive_kek(password, salt)).decrypt(wrapped)
-        except InvalidToken, ValueError, TypeError:
+        except (InvalidToken, ValueError, TypeError):
             return False
         self._fernet = Fernet(dek)
         return True
@@ -61,6 +61,10 @@ class WacliManager:
             "--store",
             self.store_dir,
             "--json",
+            # Pin the documented snake_case payload schema (wacli v0.17) so
+            # upstream struct renames can't silently break our parsing.
+            "--json-schema",
+            "v2",
             "--timeout",
             f"{int(timeout)}s",
             "--lock-wait",
@@ -80,8 +84,15 @@ class WacliManager:
         try:
             stdout, stderr = await asyncio.wait_for(proc.communicate(), timeout=timeout + 5)
         except TimeoutError:
-            proc.kill()
-            await proc.wait()
+            # SIGTERM first: since v0.18 wacli flushes its batched writer and
+            # records a clean-shutdown marker on TERM. SIGKILL only as a last
+            # resort — it risks losing buffered work mid-sync.
+            proc.terminate()
+            try:
+                await asyncio.wait_for(proc.wait(), timeout=10)
+            except TimeoutError:
+                proc.kill()
+                await proc.wait()
             return {"success": False, "error": "wacli timed out"}
         output = (stdout or b"").decode().strip().split("\n")
         last = output[-1] if output else ""
@@ -91,7 +102,7 @@ class WacliManager:
                 err_json = json.loads(err_text)
                 if isinstance(err_json, dict):
                     return err_json
-            except json.JSONDecodeError, ValueError:
+            except (json.JSONDecodeError, ValueError):
                 pass
             return {"success": False, "error": err_text}
         try:
@@ -184,6 +195,12 @@ class WacliManager:
             pass
 
     async def send_text(self, to: str, text: str) -> dict[str, Any]:
+        """Send a text message.
+
+        On transient server errors wacli (v0.15) enqueues the send into its
+        outbox and still reports success with `data.status == "queued"` —
+        treat that as "accepted, will retry", not as delivered.
+        """
         return await self._run_json(["send", "text", "--to", to, "--message", text])
 
     async def list_messages(self, limit: int = 100) -> list[dict[str, Any]]:
@@ -192,6 +209,36 @@ class WacliManager:
             return []
         return list(res.get("data", {}).get("messages") or [])
 
+    async def store_info(self) -> dict[str, Any]:
+        """Store health snapshot (wacli v0.13): schema version, per-table and
+        media sizes, FTS status. Empty dict when the binary is missing or the
+        store cannot be opened (e.g. written by a newer wacli after a
+        downgrade — wacli refuses those instead of corrupting them)."""
+        res = await self._run_json(["store", "info"], read_only=True)
+        if res.get("success") is not True:
+            return {}
+        return dict(res.get("data") or {})
+
+    async def mark_read_local(self, chat_jid: str, up_to_id: str = "") -> dict[str, Any]:
+        """Advance wacli's local read marker for a chat (wacli v0.12).
+
+        Local markers are independent of phone read receipts: humux advances
+        them after it has surfaced a chat to the user, so `chats unread
+        --local` reflects what actually crossed the agent, not what the phone
+        displayed. Nothing is sent to WhatsApp.
+        """
+        args = ["chats", "read-local", "--jid", chat_jid]
+        if up_to_id:
+            args += ["--id", up_to_id]
+        return await self._run_json(args)
+
+    async def unread_chats(self) -> list[dict[str, Any]]:
+        """Chats with messages newer than their local read marker."""
+        res = await self._run_json(["chats", "unread", "--local"], read_only=True)
+        if res.get("success") is not True:
+            return []
+        return list(res.get("data", {}).get("chats") or [])
+
     @staticmethod
     def parse_timestamp(value: str) -> datetime | None:
         if not value:
@@ -0,0 +1,42 @@
+"""Tests for the WacliManager wrapper around the wacli CLI."""
+
+from __future__ import annotations
+
+from typing import Any
+
+from core.wacli import WacliManager
+
+
+def _patch_run_json(monkeypatch, result: dict[str, Any]) -> list[tuple[list[str], dict]]:
+    """Stub out the subprocess call; return the recorded (args, kwargs) list."""
+    calls: list[tuple[list[str], dict]] = []
+
+    async def fake(self, args: list[str], **kwargs: Any) -> dict[str, Any]:
+        calls.append((args, kwargs))
+        return result
+
+    monkeypatch.setattr(WacliManager, "_run_json", fake)
+    return calls
+
+
+async def test_mark_read_local_advances_marker(monkeypatch) -> None:
+    calls = _patch_run_json(monkeypatch, {"success": True})
+    res = await WacliManager().mark_read_local("41772909259@s.whatsapp.net", up_to_id="3EB0ABC")
+    assert res["success"] is True
+    args, _ = calls[0]
+    assert args == ["chats", "read-local", "--jid", "41772909259@s.whatsapp.net", "--id", "3EB0ABC"]
+
+
+async def test_unread_chats_is_a_lock_free_read(monkeypatch) -> None:
+    calls = _patch_run_json(
+        monkeypatch, {"success": True, "data": {"chats": [{"jid": "a@g.us"}]}}
+    )
+    chats = await WacliManager().unread_chats()
+    assert [c["jid"] for c in chats] == ["a@g.us"]
+    _, kwargs = calls[0]
+    assert kwargs.get("read_only") is True
+
+
+async def test_unread_chats_empty_on_error(monkeypatch) -> None:
+    _patch_run_json(monkeypatch, {"success": False, "error": "wacli not found"})
+    assert await WacliManager().unread_chats() == []